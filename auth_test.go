@@ -0,0 +1,136 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// withAuthFixture points the package-level signingKey/authRules at a known
+// test configuration for the duration of the test, restoring whatever was
+// there before.
+func withAuthFixture(t *testing.T) {
+	t.Helper()
+	prevKey, prevRules := signingKey, authRules
+	signingKey = []byte("test-signing-key")
+	authRules = []Rule{
+		{PathPrefix: "/receipts/process", Methods: map[string]string{"POST": "receipts:write"}},
+		{PathPrefix: "/receipts/", Methods: map[string]string{"GET": "receipts:read"}},
+	}
+	t.Cleanup(func() { signingKey, authRules = prevKey, prevRules })
+}
+
+func signToken(t *testing.T, claims Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(signingKey)
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+	return signed
+}
+
+func protectedHandler() http.Handler {
+	return authMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func doRequest(t *testing.T, method, path, bearer string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, nil)
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	rec := httptest.NewRecorder()
+	protectedHandler().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAuthMiddleware_MissingBearerToken(t *testing.T) {
+	withAuthFixture(t)
+
+	rec := doRequest(t, http.MethodGet, "/receipts/abc-123", "")
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("got status %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestAuthMiddleware_ValidSignatureWrongCapability(t *testing.T) {
+	withAuthFixture(t)
+
+	token := signToken(t, Claims{
+		Cap:            []string{"receipts:write"}, // GET /receipts/{id} needs receipts:read
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(time.Hour).Unix()},
+	})
+
+	rec := doRequest(t, http.MethodGet, "/receipts/abc-123", token)
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("got status %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestAuthMiddleware_ExpiredToken(t *testing.T) {
+	withAuthFixture(t)
+
+	token := signToken(t, Claims{
+		Cap:            []string{"receipts:read"},
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(-time.Hour).Unix()},
+	})
+
+	rec := doRequest(t, http.MethodGet, "/receipts/abc-123", token)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("got status %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestAuthMiddleware_MissingExpClaim(t *testing.T) {
+	withAuthFixture(t)
+
+	token := signToken(t, Claims{Cap: []string{"receipts:read"}})
+
+	rec := doRequest(t, http.MethodGet, "/receipts/abc-123", token)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("got status %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestAuthMiddleware_ValidTokenGrantsAccess(t *testing.T) {
+	withAuthFixture(t)
+
+	token := signToken(t, Claims{
+		Cap:            []string{"receipts:read"},
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(time.Hour).Unix()},
+	})
+
+	rec := doRequest(t, http.MethodGet, "/receipts/abc-123", token)
+	if rec.Code != http.StatusOK {
+		t.Errorf("got status %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+// TestAuthMiddleware_RejectsAlgNoneConfusion pins the signing method to
+// HMAC (see parseToken), so a token that declares alg "none" - the classic
+// algorithm-confusion trick for bypassing signature verification - must be
+// rejected rather than trusted as unsigned.
+func TestAuthMiddleware_RejectsAlgNoneConfusion(t *testing.T) {
+	withAuthFixture(t)
+
+	claims := Claims{
+		Cap:            []string{"receipts:read"},
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(time.Hour).Unix()},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodNone, claims)
+	unsigned, err := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatalf("signing none-alg token: %v", err)
+	}
+
+	rec := doRequest(t, http.MethodGet, "/receipts/abc-123", unsigned)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("got status %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
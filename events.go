@@ -0,0 +1,154 @@
+package main
+
+import (
+	"regexp"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// ReceiptProcessed is published every time a receipt finishes processing.
+type ReceiptProcessed struct {
+	ID       string `json:"id"`
+	Points   int    `json:"points"`
+	Retailer string `json:"retailer"`
+	Total    string `json:"total"`
+}
+
+// Event is the envelope delivered to subscribers.
+type Event struct {
+	Method string           `json:"method"`
+	Params ReceiptProcessed `json:"params"`
+}
+
+// Filter selects which published events a subscriber receives. A zero
+// value Filter matches everything.
+type Filter struct {
+	RetailerRegexp string   `json:"retailerRegexp"`
+	MinPoints      int      `json:"minPoints"`
+	MaxPoints      int      `json:"maxPoints"` // 0 means "no upper bound"
+	IDs            []string `json:"ids"`
+
+	retailerRe *regexp.Regexp
+}
+
+func (f *Filter) compile() error {
+	if f.RetailerRegexp == "" {
+		return nil
+	}
+	re, err := regexp.Compile(f.RetailerRegexp)
+	if err != nil {
+		return err
+	}
+	f.retailerRe = re
+	return nil
+}
+
+func (f *Filter) matches(e ReceiptProcessed) bool {
+	if f.retailerRe != nil && !f.retailerRe.MatchString(e.Retailer) {
+		return false
+	}
+	if f.MinPoints != 0 && e.Points < f.MinPoints {
+		return false
+	}
+	if f.MaxPoints != 0 && e.Points > f.MaxPoints {
+		return false
+	}
+	if len(f.IDs) > 0 {
+		found := false
+		for _, id := range f.IDs {
+			if id == e.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// subscriberBufferSize bounds how many unconsumed events a subscriber can
+// queue before a send to it starts counting as missed.
+const subscriberBufferSize = 32
+
+// maxMissedEvents is how many consecutive full-buffer sends a subscriber
+// is allowed before it's considered too slow and dropped.
+const maxMissedEvents = 10
+
+type subscriber struct {
+	filter Filter
+	ch     chan Event
+	missed int
+}
+
+// EventBus fans out ReceiptProcessed events to subscribers whose Filter
+// matches, dropping subscribers that fall behind rather than blocking
+// publishers.
+type EventBus struct {
+	mu          sync.Mutex
+	subscribers map[string]*subscriber
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{subscribers: make(map[string]*subscriber)}
+}
+
+// Subscribe registers filter and returns a subscription id and the
+// channel events will arrive on.
+func (b *EventBus) Subscribe(filter Filter) (string, <-chan Event, error) {
+	if err := filter.compile(); err != nil {
+		return "", nil, err
+	}
+
+	id := uuid.New().String()
+	sub := &subscriber{filter: filter, ch: make(chan Event, subscriberBufferSize)}
+
+	b.mu.Lock()
+	b.subscribers[id] = sub
+	b.mu.Unlock()
+
+	return id, sub.ch, nil
+}
+
+// Unsubscribe removes a subscription and closes its channel.
+func (b *EventBus) Unsubscribe(id string) {
+	b.mu.Lock()
+	sub, ok := b.subscribers[id]
+	delete(b.subscribers, id)
+	b.mu.Unlock()
+
+	if ok {
+		close(sub.ch)
+	}
+}
+
+// Publish delivers event to every matching subscriber. A subscriber is
+// only dropped once its buffer has been full for maxMissedEvents
+// consecutive publishes, rather than on the first missed send.
+func (b *EventBus) Publish(event ReceiptProcessed) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	msg := Event{Method: "receiptProcessed", Params: event}
+	for id, sub := range b.subscribers {
+		if !sub.filter.matches(event) {
+			continue
+		}
+		select {
+		case sub.ch <- msg:
+			sub.missed = 0
+		default:
+			sub.missed++
+			if sub.missed >= maxMissedEvents {
+				// Subscriber has been too slow for too long; drop it
+				// instead of letting it back up the publisher forever.
+				delete(b.subscribers, id)
+				close(sub.ch)
+			}
+		}
+	}
+}
+
+var eventBus = NewEventBus()
@@ -0,0 +1,128 @@
+package main
+
+import "testing"
+
+func TestFilter_Matches(t *testing.T) {
+	event := ReceiptProcessed{ID: "r1", Points: 42, Retailer: "Target", Total: "35.35"}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{name: "zero value matches everything", filter: Filter{}, want: true},
+		{name: "retailer regexp matches", filter: Filter{RetailerRegexp: "^Tar"}, want: true},
+		{name: "retailer regexp does not match", filter: Filter{RetailerRegexp: "^Walmart"}, want: false},
+		{name: "within min/max points", filter: Filter{MinPoints: 10, MaxPoints: 50}, want: true},
+		{name: "below min points", filter: Filter{MinPoints: 50}, want: false},
+		{name: "above max points", filter: Filter{MaxPoints: 10}, want: false},
+		{name: "max points zero means unbounded", filter: Filter{MinPoints: 1, MaxPoints: 0}, want: true},
+		{name: "id in list", filter: Filter{IDs: []string{"other", "r1"}}, want: true},
+		{name: "id not in list", filter: Filter{IDs: []string{"other"}}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.filter.compile(); err != nil {
+				t.Fatalf("compile: %v", err)
+			}
+			if got := tc.filter.matches(event); got != tc.want {
+				t.Errorf("matches: got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFilter_CompileRejectsInvalidRegexp(t *testing.T) {
+	f := Filter{RetailerRegexp: "("}
+	if err := f.compile(); err == nil {
+		t.Fatal("compile: expected an error for an invalid regexp, got nil")
+	}
+}
+
+// fillBuffer publishes enough matching events to fill sub's channel without
+// draining it, so every subsequent Publish takes the "buffer full" branch.
+func fillBuffer(bus *EventBus, event ReceiptProcessed) {
+	for i := 0; i < subscriberBufferSize; i++ {
+		bus.Publish(event)
+	}
+}
+
+func TestEventBus_PublishEvictsAfterConsecutiveMisses(t *testing.T) {
+	bus := NewEventBus()
+	event := ReceiptProcessed{ID: "r1", Points: 1, Retailer: "Target"}
+
+	id, _, err := bus.Subscribe(Filter{})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	fillBuffer(bus, event) // fills the channel; none of these count as missed
+
+	for i := 0; i < maxMissedEvents-1; i++ {
+		bus.Publish(event)
+		if _, ok := bus.subscribers[id]; !ok {
+			t.Fatalf("subscriber evicted after only %d consecutive misses, want %d", i+1, maxMissedEvents)
+		}
+	}
+
+	// One more consecutive miss crosses the threshold.
+	bus.Publish(event)
+	if _, ok := bus.subscribers[id]; ok {
+		t.Fatalf("subscriber still present after %d consecutive misses, want evicted", maxMissedEvents)
+	}
+}
+
+func TestEventBus_PublishSurvivesInterleavedSuccess(t *testing.T) {
+	bus := NewEventBus()
+	event := ReceiptProcessed{ID: "r1", Points: 1, Retailer: "Target"}
+
+	id, ch, err := bus.Subscribe(Filter{})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	fillBuffer(bus, event)
+
+	// Miss almost up to the eviction threshold...
+	for i := 0; i < maxMissedEvents-1; i++ {
+		bus.Publish(event)
+	}
+	if _, ok := bus.subscribers[id]; !ok {
+		t.Fatal("subscriber evicted before reaching maxMissedEvents")
+	}
+
+	// ...then drain one slot so the next publish succeeds and resets the
+	// miss counter instead of evicting.
+	<-ch
+	bus.Publish(event)
+	if sub, ok := bus.subscribers[id]; !ok {
+		t.Fatal("subscriber evicted on a publish that should have succeeded")
+	} else if sub.missed != 0 {
+		t.Errorf("missed = %d after a successful send, want 0", sub.missed)
+	}
+
+	// The bus should now tolerate another maxMissedEvents-1 consecutive
+	// misses without evicting, proving the earlier near-miss streak wasn't
+	// carried over.
+	for i := 0; i < maxMissedEvents-1; i++ {
+		bus.Publish(event)
+		if _, ok := bus.subscribers[id]; !ok {
+			t.Fatalf("subscriber evicted after only %d consecutive misses post-reset", i+1)
+		}
+	}
+}
+
+func TestEventBus_UnsubscribeClosesChannel(t *testing.T) {
+	bus := NewEventBus()
+	id, ch, err := bus.Subscribe(Filter{})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	bus.Unsubscribe(id)
+
+	if _, ok := <-ch; ok {
+		t.Error("expected channel to be closed after Unsubscribe")
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gorilla/mux"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/Ashish372/fetch_OA/pkg/pb"
+)
+
+// gatewayMarshaler is the same protojson-based marshaler grpc-gateway's
+// generated code uses, so the REST JSON shape (lowerCamelCase field
+// names) matches what protoc-gen-grpc-gateway would have produced from
+// the proto's field names. EmitUnpopulated matches grpc-gateway's default
+// mux marshaler, so zero-valued fields (e.g. Points: 0) are still emitted
+// instead of being dropped.
+var gatewayMarshaler = &gwruntime.JSONPb{MarshalOptions: protojson.MarshalOptions{EmitUnpopulated: true}}
+
+// gatewayClient is dialed against our own gRPC server (serveGRPC), so the
+// REST handlers below do nothing but translate JSON<->proto and forward
+// to the exact same RPCs the gRPC surface exposes. REST and gRPC can't
+// drift apart because REST *is* a gRPC client here.
+var gatewayClient pb.ReceiptsClient
+
+// dialGateway opens the client connection the REST handlers forward
+// through. grpc.NewClient doesn't dial eagerly, so this is safe to call
+// before serveGRPC's listener is up.
+func dialGateway() error {
+	conn, err := grpc.NewClient("localhost"+*grpcAddrFlag, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	gatewayClient = pb.NewReceiptsClient(conn)
+	return nil
+}
+
+// gatewayContext forwards r's Authorization header as outgoing gRPC
+// metadata, so authUnaryInterceptor/authStreamInterceptor (grpc_server.go)
+// see the same bearer token authMiddleware already checked on the way in.
+func gatewayContext(r *http.Request) context.Context {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		return r.Context()
+	}
+	return metadata.AppendToOutgoingContext(r.Context(), "authorization", authHeader)
+}
+
+// handleProcessReceiptGateway is the grpc-gateway-style REST handler for
+// ProcessReceipt: decode the JSON body into a pb.Receipt, call the gRPC
+// method, and re-encode its response the way protoc-gen-grpc-gateway's
+// generated handler would.
+func handleProcessReceiptGateway(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	var req pb.Receipt
+	if err := gatewayMarshaler.Unmarshal(body, &req); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := gatewayClient.ProcessReceipt(gatewayContext(r), &req)
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+
+	writeGatewayResponse(w, resp)
+}
+
+// handleGetPointsGateway is the grpc-gateway-style REST handler for
+// GetPoints, with {id} bound from the URL the way a
+// `get: "/receipts/{id}"` annotation would bind it.
+func handleGetPointsGateway(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	resp, err := gatewayClient.GetPoints(gatewayContext(r), &pb.ReceiptID{Id: id})
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+
+	writeGatewayResponse(w, resp)
+}
+
+func writeGatewayResponse(w http.ResponseWriter, msg proto.Message) {
+	data, err := gatewayMarshaler.Marshal(msg)
+	if err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// writeGatewayError maps NotFound, InvalidArgument, PermissionDenied and
+// Unauthenticated to the HTTP status grpc-gateway's
+// runtime.DefaultHTTPErrorHandler uses for them; every other gRPC status
+// code becomes 500.
+func writeGatewayError(w http.ResponseWriter, err error) {
+	st, _ := status.FromError(err)
+	code := http.StatusInternalServerError
+	switch st.Code() {
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	}
+	http.Error(w, st.Message(), code)
+}
@@ -0,0 +1,6 @@
+// Package pb holds the generated receipts.v1 client/server stubs built
+// from proto/receipts/v1/receipts.proto. Run `go generate ./...` after
+// editing the proto to regenerate receipts.pb.go and receipts_grpc.pb.go.
+package pb
+
+//go:generate buf generate --template ../../buf.gen.yaml -o ../.. ../../proto
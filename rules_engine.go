@@ -0,0 +1,296 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"os/signal"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+var pointsRulesFileFlag = flag.String("points-rules-file", "points_rules.json", "path to the JSON config describing the points rule engine")
+
+// PointsRule is a single scoring rule the engine runs against a receipt.
+// Apply returns the points it awards and a human-readable explanation of
+// how it arrived at that number.
+type PointsRule interface {
+	Name() string
+	Apply(Receipt) (points int, explanation string)
+}
+
+// RuleResult is one rule's contribution to a receipt's total, as
+// returned by the breakdown endpoint.
+type RuleResult struct {
+	Rule        string `json:"rule"`
+	Points      int    `json:"points"`
+	Explanation string `json:"explanation"`
+}
+
+// ruleConfig is the on-disk shape of a single configured rule.
+type ruleConfig struct {
+	Type   string          `json:"type"`
+	Params json.RawMessage `json:"params"`
+}
+
+// RuleEngine runs a configured, ordered list of PointsRules against a
+// receipt and can be reloaded from disk without restarting the process.
+type RuleEngine struct {
+	mu    sync.RWMutex
+	rules []PointsRule
+	path  string
+}
+
+func NewRuleEngine(path string) *RuleEngine {
+	return &RuleEngine{path: path}
+}
+
+// Load reads the engine's config file and atomically swaps in the newly
+// built rule set.
+func (e *RuleEngine) Load() error {
+	data, err := os.ReadFile(e.path)
+	if err != nil {
+		return fmt.Errorf("reading points rules file: %w", err)
+	}
+
+	var configs []ruleConfig
+	if err := json.Unmarshal(data, &configs); err != nil {
+		return fmt.Errorf("parsing points rules file: %w", err)
+	}
+
+	rules := make([]PointsRule, 0, len(configs))
+	for _, c := range configs {
+		rule, err := buildRule(c)
+		if err != nil {
+			return fmt.Errorf("rule %q: %w", c.Type, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	e.mu.Lock()
+	e.rules = rules
+	e.mu.Unlock()
+	return nil
+}
+
+// WatchSIGHUP reloads the engine's config every time the process
+// receives SIGHUP, logging (but not failing on) reload errors.
+func (e *RuleEngine) WatchSIGHUP() {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	go func() {
+		for range sighup {
+			if err := e.Load(); err != nil {
+				fmt.Printf("points rule reload failed: %v\n", err)
+				continue
+			}
+			fmt.Println("points rules reloaded via SIGHUP")
+		}
+	}()
+}
+
+// Calculate runs every configured rule against receipt and returns the
+// total points along with each rule's individual contribution.
+func (e *RuleEngine) Calculate(receipt Receipt) (int, []RuleResult) {
+	e.mu.RLock()
+	rules := e.rules
+	e.mu.RUnlock()
+
+	total := 0
+	breakdown := make([]RuleResult, 0, len(rules))
+	for _, rule := range rules {
+		points, explanation := rule.Apply(receipt)
+		total += points
+		breakdown = append(breakdown, RuleResult{Rule: rule.Name(), Points: points, Explanation: explanation})
+	}
+	return total, breakdown
+}
+
+func buildRule(c ruleConfig) (PointsRule, error) {
+	switch c.Type {
+	case "alphanumeric_retailer":
+		var p struct {
+			PointsPerChar int `json:"points_per_char"`
+		}
+		if err := json.Unmarshal(c.Params, &p); err != nil {
+			return nil, err
+		}
+		if p.PointsPerChar <= 0 {
+			return nil, fmt.Errorf("points_per_char must be positive, got %d", p.PointsPerChar)
+		}
+		return alphanumericRetailerRule{pointsPerChar: p.PointsPerChar}, nil
+
+	case "round_dollar_bonus":
+		var p struct {
+			Points int `json:"points"`
+		}
+		if err := json.Unmarshal(c.Params, &p); err != nil {
+			return nil, err
+		}
+		return roundDollarBonusRule{points: p.Points}, nil
+
+	case "quarter_total_bonus":
+		var p struct {
+			Points int `json:"points"`
+		}
+		if err := json.Unmarshal(c.Params, &p); err != nil {
+			return nil, err
+		}
+		return quarterTotalBonusRule{points: p.Points}, nil
+
+	case "item_pair_bonus":
+		var p struct {
+			PointsPerPair int `json:"points_per_pair"`
+		}
+		if err := json.Unmarshal(c.Params, &p); err != nil {
+			return nil, err
+		}
+		return itemPairBonusRule{pointsPerPair: p.PointsPerPair}, nil
+
+	case "item_description_multiple":
+		var p struct {
+			Modulo          int     `json:"modulo"`
+			PriceMultiplier float64 `json:"price_multiplier"`
+		}
+		if err := json.Unmarshal(c.Params, &p); err != nil {
+			return nil, err
+		}
+		if p.Modulo == 0 {
+			return nil, fmt.Errorf("modulo must not be zero")
+		}
+		if p.PriceMultiplier <= 0 {
+			return nil, fmt.Errorf("price_multiplier must be positive, got %v", p.PriceMultiplier)
+		}
+		return itemDescriptionMultipleRule{modulo: p.Modulo, priceMultiplier: p.PriceMultiplier}, nil
+
+	case "odd_purchase_day_bonus":
+		var p struct {
+			Points int `json:"points"`
+		}
+		if err := json.Unmarshal(c.Params, &p); err != nil {
+			return nil, err
+		}
+		return oddPurchaseDayBonusRule{points: p.Points}, nil
+
+	case "time_window_bonus":
+		var p struct {
+			Start  string `json:"start"`
+			End    string `json:"end"`
+			Points int    `json:"points"`
+		}
+		if err := json.Unmarshal(c.Params, &p); err != nil {
+			return nil, err
+		}
+		start, err := time.Parse("15:04", p.Start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start time: %w", err)
+		}
+		end, err := time.Parse("15:04", p.End)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end time: %w", err)
+		}
+		return timeWindowBonusRule{start: start, end: end, points: p.Points}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown rule type")
+	}
+}
+
+var alphanumericRe = regexp.MustCompile(`[a-zA-Z0-9]`)
+
+type alphanumericRetailerRule struct{ pointsPerChar int }
+
+func (r alphanumericRetailerRule) Name() string { return "alphanumeric_retailer" }
+
+func (r alphanumericRetailerRule) Apply(receipt Receipt) (int, string) {
+	count := len(alphanumericRe.FindAllString(receipt.Retailer, -1))
+	points := count * r.pointsPerChar
+	return points, fmt.Sprintf("%d points for %d alphanumeric characters in retailer name (%s)", points, count, receipt.Retailer)
+}
+
+type roundDollarBonusRule struct{ points int }
+
+func (r roundDollarBonusRule) Name() string { return "round_dollar_bonus" }
+
+func (r roundDollarBonusRule) Apply(receipt Receipt) (int, string) {
+	if strings.HasSuffix(receipt.Total, ".00") {
+		return r.points, fmt.Sprintf("%d points: total (%s) is a round dollar amount", r.points, receipt.Total)
+	}
+	return 0, fmt.Sprintf("0 points: total (%s) is not a round dollar amount", receipt.Total)
+}
+
+type quarterTotalBonusRule struct{ points int }
+
+func (r quarterTotalBonusRule) Name() string { return "quarter_total_bonus" }
+
+func (r quarterTotalBonusRule) Apply(receipt Receipt) (int, string) {
+	total, _ := strconv.ParseFloat(receipt.Total, 64)
+	if math.Mod(total, 0.25) == 0 {
+		return r.points, fmt.Sprintf("%d points: total (%s) is a multiple of 0.25", r.points, receipt.Total)
+	}
+	return 0, fmt.Sprintf("0 points: total (%s) is not a multiple of 0.25", receipt.Total)
+}
+
+type itemPairBonusRule struct{ pointsPerPair int }
+
+func (r itemPairBonusRule) Name() string { return "item_pair_bonus" }
+
+func (r itemPairBonusRule) Apply(receipt Receipt) (int, string) {
+	pairs := len(receipt.Items) / 2
+	points := pairs * r.pointsPerPair
+	return points, fmt.Sprintf("%d points for %d pairs of items (%d items)", points, pairs, len(receipt.Items))
+}
+
+type itemDescriptionMultipleRule struct {
+	modulo          int
+	priceMultiplier float64
+}
+
+func (r itemDescriptionMultipleRule) Name() string { return "item_description_multiple" }
+
+func (r itemDescriptionMultipleRule) Apply(receipt Receipt) (int, string) {
+	total := 0
+	for _, item := range receipt.Items {
+		descriptionLength := len(strings.TrimSpace(item.ShortDescription))
+		if descriptionLength%r.modulo == 0 {
+			price, _ := strconv.ParseFloat(item.Price, 64)
+			total += int(math.Ceil(price * r.priceMultiplier))
+		}
+	}
+	return total, fmt.Sprintf("%d points for item descriptions with length a multiple of %d", total, r.modulo)
+}
+
+type oddPurchaseDayBonusRule struct{ points int }
+
+func (r oddPurchaseDayBonusRule) Name() string { return "odd_purchase_day_bonus" }
+
+func (r oddPurchaseDayBonusRule) Apply(receipt Receipt) (int, string) {
+	purchaseDate, err := time.Parse("2006-01-02", receipt.PurchaseDate)
+	if err == nil && purchaseDate.Day()%2 != 0 {
+		return r.points, fmt.Sprintf("%d points: purchase date (%s) falls on an odd day", r.points, receipt.PurchaseDate)
+	}
+	return 0, fmt.Sprintf("0 points: purchase date (%s) falls on an even day", receipt.PurchaseDate)
+}
+
+type timeWindowBonusRule struct {
+	start, end time.Time
+	points     int
+}
+
+func (r timeWindowBonusRule) Name() string { return "time_window_bonus" }
+
+func (r timeWindowBonusRule) Apply(receipt Receipt) (int, string) {
+	purchaseTime, err := time.Parse("15:04", receipt.PurchaseTime)
+	// start is inclusive (the original hard-coded rule treated exactly
+	// 14:00 as bonus-eligible) and end is exclusive.
+	if err == nil && !purchaseTime.Before(r.start) && purchaseTime.Before(r.end) {
+		return r.points, fmt.Sprintf("%d points: purchase time (%s) is within the bonus window", r.points, receipt.PurchaseTime)
+	}
+	return 0, fmt.Sprintf("0 points: purchase time (%s) is outside the bonus window", receipt.PurchaseTime)
+}
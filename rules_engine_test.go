@@ -0,0 +1,165 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// targetReceipt is the classic Fetch Rewards sample receipt; it exercises
+// every rule type this engine ships with.
+var targetReceipt = Receipt{
+	Retailer:     "Target",
+	PurchaseDate: "2022-01-01",
+	PurchaseTime: "13:01",
+	Items: []Item{
+		{ShortDescription: "Mountain Dew 12PK", Price: "6.49"},
+		{ShortDescription: "Emils Cheese Pizza", Price: "12.25"},
+		{ShortDescription: "Knorr Creamy Chicken", Price: "1.26"},
+		{ShortDescription: "Doritos Nacho Cheese", Price: "3.35"},
+		{ShortDescription: "   Klarbrunn 12-PK 12 FL OZ  ", Price: "12.00"},
+	},
+	Total: "35.35",
+}
+
+// writeRulesFile marshals configs to a temp file and returns its path, so
+// RuleEngine.Load can be exercised against arbitrary rule sets without
+// touching the repo's points_rules.json.
+func writeRulesFile(t *testing.T, configs []ruleConfig) string {
+	t.Helper()
+	data, err := json.Marshal(configs)
+	if err != nil {
+		t.Fatalf("marshaling rule configs: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "points_rules.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("writing rules file: %v", err)
+	}
+	return path
+}
+
+func ruleConfigFor(t *testing.T, ruleType string, params interface{}) ruleConfig {
+	t.Helper()
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Fatalf("marshaling params for %s: %v", ruleType, err)
+	}
+	return ruleConfig{Type: ruleType, Params: data}
+}
+
+// defaultConfigs mirrors points_rules.json so the test suite stays in sync
+// with the shipped rule set without reading it off disk, except that its
+// time_window_bonus entry carries no points (a map[string]string cannot
+// hold the int); tests that need those 10 points replace that entry.
+func defaultConfigs(t *testing.T) []ruleConfig {
+	t.Helper()
+	return []ruleConfig{
+		ruleConfigFor(t, "alphanumeric_retailer", map[string]int{"points_per_char": 1}),
+		ruleConfigFor(t, "round_dollar_bonus", map[string]int{"points": 50}),
+		ruleConfigFor(t, "quarter_total_bonus", map[string]int{"points": 25}),
+		ruleConfigFor(t, "item_pair_bonus", map[string]int{"points_per_pair": 5}),
+		ruleConfigFor(t, "item_description_multiple", map[string]float64{"modulo": 3, "price_multiplier": 0.2}),
+		ruleConfigFor(t, "odd_purchase_day_bonus", map[string]int{"points": 6}),
+		ruleConfigFor(t, "time_window_bonus", map[string]string{"start": "14:00", "end": "16:00"}),
+	}
+}
+
+func TestRuleEngine_CalculateMatchesKnownReceipt(t *testing.T) {
+	// time_window_bonus needs an int points field too; build it directly
+	// since the map literal above can't mix string and int values.
+	configs := defaultConfigs(t)
+	configs[6] = ruleConfigFor(t, "time_window_bonus", struct {
+		Start  string `json:"start"`
+		End    string `json:"end"`
+		Points int    `json:"points"`
+	}{Start: "14:00", End: "16:00", Points: 10})
+
+	engine := NewRuleEngine(writeRulesFile(t, configs))
+	if err := engine.Load(); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	total, breakdown := engine.Calculate(targetReceipt)
+	if total != 28 {
+		t.Errorf("Calculate: got %d points, want 28", total)
+	}
+
+	want := map[string]int{
+		"alphanumeric_retailer":     6,
+		"round_dollar_bonus":        0,
+		"quarter_total_bonus":       0,
+		"item_pair_bonus":           10,
+		"item_description_multiple": 6,
+		"odd_purchase_day_bonus":    6,
+		"time_window_bonus":         0,
+	}
+	if len(breakdown) != len(want) {
+		t.Fatalf("Calculate: got %d breakdown entries, want %d", len(breakdown), len(want))
+	}
+	for _, r := range breakdown {
+		if got, ok := want[r.Rule]; !ok {
+			t.Errorf("Calculate: unexpected rule %q in breakdown", r.Rule)
+		} else if got != r.Points {
+			t.Errorf("Calculate: rule %q contributed %d points, want %d", r.Rule, r.Points, got)
+		}
+	}
+}
+
+func TestBuildRule_RejectsUnsafeConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config ruleConfig
+	}{
+		{
+			name:   "item_description_multiple zero modulo",
+			config: ruleConfigFor(t, "item_description_multiple", map[string]float64{"modulo": 0, "price_multiplier": 0.2}),
+		},
+		{
+			name:   "item_description_multiple non-positive price_multiplier",
+			config: ruleConfigFor(t, "item_description_multiple", map[string]float64{"modulo": 3, "price_multiplier": 0}),
+		},
+		{
+			name:   "alphanumeric_retailer non-positive points_per_char",
+			config: ruleConfigFor(t, "alphanumeric_retailer", map[string]int{"points_per_char": 0}),
+		},
+		{
+			name:   "unknown rule type",
+			config: ruleConfig{Type: "not_a_real_rule"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := buildRule(tc.config); err == nil {
+				t.Errorf("buildRule(%+v): expected an error, got nil", tc.config)
+			}
+		})
+	}
+}
+
+func TestRuleEngine_LoadRejectsBadConfigAndKeepsPriorRules(t *testing.T) {
+	engine := NewRuleEngine(writeRulesFile(t, defaultConfigs(t)[:1])) // just alphanumeric_retailer
+	if err := engine.Load(); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	total, _ := engine.Calculate(targetReceipt)
+	if total != 6 {
+		t.Fatalf("Calculate: got %d points, want 6", total)
+	}
+
+	badPath := writeRulesFile(t, []ruleConfig{
+		ruleConfigFor(t, "item_description_multiple", map[string]float64{"modulo": 0, "price_multiplier": 0.2}),
+	})
+	engine.path = badPath
+	if err := engine.Load(); err == nil {
+		t.Fatalf("Load: expected an error for a zero modulo, got nil")
+	}
+
+	// A failed reload must not clobber the previously loaded rules.
+	total, _ = engine.Calculate(targetReceipt)
+	if total != 6 {
+		t.Errorf("Calculate after failed reload: got %d points, want 6 (prior rules should still apply)", total)
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sync"
+)
+
+// Store is the persistence boundary for processed receipts. Handlers
+// depend only on this interface so the backend can be swapped with
+// --store without touching request handling.
+type Store interface {
+	SaveReceipt(id string, r Receipt, points int, breakdown []RuleResult) error
+	GetPoints(id string) (int, bool, error)
+	GetReceipt(id string) (Receipt, bool, error)
+	GetBreakdown(id string) ([]RuleResult, bool, error)
+}
+
+var (
+	storeFlag = flag.String("store", "memory", "persistence backend to use: memory, redis, or postgres")
+
+	redisAddrFlag   = flag.String("redis-addr", "localhost:6379", "address of the Redis server (used when --store=redis)")
+	redisPrefixFlag = flag.String("redis-prefix", "fetch_oa:", "key prefix for receipts stored in Redis")
+	redisTTLFlag    = flag.Duration("redis-ttl", 0, "TTL applied to receipts stored in Redis; 0 disables expiry")
+
+	postgresDSNFlag = flag.String("postgres-dsn", "", "Postgres connection string (used when --store=postgres)")
+)
+
+// newStore builds the Store selected by --store.
+func newStore() (Store, error) {
+	switch *storeFlag {
+	case "", "memory":
+		return NewMemoryStore(), nil
+	case "redis":
+		return NewRedisStore(*redisAddrFlag, *redisPrefixFlag, *redisTTLFlag)
+	case "postgres":
+		return NewPostgresStore(*postgresDSNFlag)
+	default:
+		return nil, fmt.Errorf("unknown store backend %q", *storeFlag)
+	}
+}
+
+// MemoryStore is the default, in-process Store. Data does not survive a
+// restart.
+type MemoryStore struct {
+	mu         sync.Mutex
+	receipts   map[string]Receipt
+	points     map[string]int
+	breakdowns map[string][]RuleResult
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		receipts:   make(map[string]Receipt),
+		points:     make(map[string]int),
+		breakdowns: make(map[string][]RuleResult),
+	}
+}
+
+func (s *MemoryStore) SaveReceipt(id string, r Receipt, points int, breakdown []RuleResult) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.receipts[id] = r
+	s.points[id] = points
+	s.breakdowns[id] = breakdown
+	return nil
+}
+
+func (s *MemoryStore) GetPoints(id string) (int, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.points[id]
+	return p, ok, nil
+}
+
+func (s *MemoryStore) GetReceipt(id string) (Receipt, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.receipts[id]
+	return r, ok, nil
+}
+
+func (s *MemoryStore) GetBreakdown(id string) ([]RuleResult, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.breakdowns[id]
+	return b, ok, nil
+}
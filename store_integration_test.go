@@ -0,0 +1,66 @@
+//go:build integration
+
+// Run with: go test -tags=integration ./...
+// Spins up real Redis and Postgres containers via testcontainers-go, so it
+// requires a working Docker daemon and is excluded from the default
+// `go test ./...` run.
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	tcredis "github.com/testcontainers/testcontainers-go/modules/redis"
+)
+
+func TestRedisStore_Integration(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := tcredis.Run(ctx, "docker.io/redis:7")
+	if err != nil {
+		t.Fatalf("starting redis container: %v", err)
+	}
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	connStr, err := container.ConnectionString(ctx)
+	if err != nil {
+		t.Fatalf("getting redis connection string: %v", err)
+	}
+	addr := connStr[len("redis://"):]
+
+	s, err := NewRedisStore(addr, "fetch_oa_test:", time.Minute)
+	if err != nil {
+		t.Fatalf("NewRedisStore: %v", err)
+	}
+
+	testStoreImplementation(t, s)
+}
+
+func TestPostgresStore_Integration(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := tcpostgres.Run(ctx, "docker.io/postgres:16-alpine",
+		tcpostgres.WithDatabase("fetch_oa_test"),
+		tcpostgres.WithUsername("postgres"),
+		tcpostgres.WithPassword("postgres"),
+		tcpostgres.WithInitScripts("migrations/0001_create_receipts.sql", "migrations/0002_add_breakdown.sql"),
+	)
+	if err != nil {
+		t.Fatalf("starting postgres container: %v", err)
+	}
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	dsn, err := container.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		t.Fatalf("getting postgres connection string: %v", err)
+	}
+
+	s, err := NewPostgresStore(dsn)
+	if err != nil {
+		t.Fatalf("NewPostgresStore: %v", err)
+	}
+
+	testStoreImplementation(t, s)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+)
+
+// PostgresStore persists receipts in a `receipts` table (see
+// migrations/0001_create_receipts.sql and migrations/0002_add_breakdown.sql)
+// so they survive process restarts and can be shared across instances.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+func NewPostgresStore(dsn string) (*PostgresStore, error) {
+	if dsn == "" {
+		return nil, fmt.Errorf("--postgres-dsn is required when --store=postgres")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("opening postgres connection: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("connecting to postgres: %w", err)
+	}
+	return &PostgresStore{db: db}, nil
+}
+
+func (s *PostgresStore) SaveReceipt(id string, r Receipt, points int, breakdown []RuleResult) error {
+	payload, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("encoding receipt: %w", err)
+	}
+	breakdownPayload, err := json.Marshal(breakdown)
+	if err != nil {
+		return fmt.Errorf("encoding breakdown: %w", err)
+	}
+
+	_, err = s.db.Exec(
+		`INSERT INTO receipts (id, payload, points, breakdown) VALUES ($1, $2, $3, $4)
+		 ON CONFLICT (id) DO UPDATE SET payload = EXCLUDED.payload, points = EXCLUDED.points, breakdown = EXCLUDED.breakdown`,
+		id, payload, points, breakdownPayload,
+	)
+	if err != nil {
+		return fmt.Errorf("saving receipt %s: %w", id, err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) GetPoints(id string) (int, bool, error) {
+	// An id that is not a UUID cannot match the uuid primary key; report it
+	// as not found rather than letting Postgres reject the query.
+	if _, err := uuid.Parse(id); err != nil {
+		return 0, false, nil
+	}
+	var points int
+	err := s.db.QueryRow(`SELECT points FROM receipts WHERE id = $1`, id).Scan(&points)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("reading points for %s: %w", id, err)
+	}
+	return points, true, nil
+}
+
+func (s *PostgresStore) GetReceipt(id string) (Receipt, bool, error) {
+	var payload []byte
+	err := s.db.QueryRow(`SELECT payload FROM receipts WHERE id = $1`, id).Scan(&payload)
+	if err == sql.ErrNoRows {
+		return Receipt{}, false, nil
+	}
+	if err != nil {
+		return Receipt{}, false, fmt.Errorf("reading receipt %s: %w", id, err)
+	}
+
+	var r Receipt
+	if err := json.Unmarshal(payload, &r); err != nil {
+		return Receipt{}, false, fmt.Errorf("decoding receipt %s: %w", id, err)
+	}
+	return r, true, nil
+}
+
+func (s *PostgresStore) GetBreakdown(id string) ([]RuleResult, bool, error) {
+	var payload []byte
+	err := s.db.QueryRow(`SELECT breakdown FROM receipts WHERE id = $1`, id).Scan(&payload)
+	if err == sql.ErrNoRows {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("reading breakdown for %s: %w", id, err)
+	}
+
+	var breakdown []RuleResult
+	if err := json.Unmarshal(payload, &breakdown); err != nil {
+		return nil, false, fmt.Errorf("decoding breakdown for %s: %w", id, err)
+	}
+	return breakdown, true, nil
+}
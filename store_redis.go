@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisRecord is what gets JSON-encoded into the Redis value; it bundles
+// the receipt with its computed points and rule breakdown so a single
+// GET can serve any of the three accessors.
+type redisRecord struct {
+	Receipt   Receipt      `json:"receipt"`
+	Points    int          `json:"points"`
+	Breakdown []RuleResult `json:"breakdown"`
+}
+
+// RedisStore persists receipts in Redis, keyed by prefix+id, with an
+// optional TTL so stale receipts can be left to expire.
+type RedisStore struct {
+	client *redis.Client
+	prefix string
+	ttl    time.Duration
+	ctx    context.Context
+}
+
+func NewRedisStore(addr, prefix string, ttl time.Duration) (*RedisStore, error) {
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	ctx := context.Background()
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("connecting to redis at %s: %w", addr, err)
+	}
+	return &RedisStore{client: client, prefix: prefix, ttl: ttl, ctx: ctx}, nil
+}
+
+func (s *RedisStore) key(id string) string {
+	return s.prefix + id
+}
+
+func (s *RedisStore) SaveReceipt(id string, r Receipt, points int, breakdown []RuleResult) error {
+	data, err := json.Marshal(redisRecord{Receipt: r, Points: points, Breakdown: breakdown})
+	if err != nil {
+		return fmt.Errorf("encoding receipt: %w", err)
+	}
+	return s.client.Set(s.ctx, s.key(id), data, s.ttl).Err()
+}
+
+func (s *RedisStore) GetPoints(id string) (int, bool, error) {
+	record, ok, err := s.get(id)
+	if err != nil || !ok {
+		return 0, ok, err
+	}
+	return record.Points, true, nil
+}
+
+func (s *RedisStore) GetReceipt(id string) (Receipt, bool, error) {
+	record, ok, err := s.get(id)
+	if err != nil || !ok {
+		return Receipt{}, ok, err
+	}
+	return record.Receipt, true, nil
+}
+
+func (s *RedisStore) GetBreakdown(id string) ([]RuleResult, bool, error) {
+	record, ok, err := s.get(id)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+	return record.Breakdown, true, nil
+}
+
+func (s *RedisStore) get(id string) (redisRecord, bool, error) {
+	data, err := s.client.Get(s.ctx, s.key(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return redisRecord{}, false, nil
+	}
+	if err != nil {
+		return redisRecord{}, false, fmt.Errorf("reading receipt %s from redis: %w", id, err)
+	}
+
+	var record redisRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return redisRecord{}, false, fmt.Errorf("decoding receipt %s: %w", id, err)
+	}
+	return record, true, nil
+}
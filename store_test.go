@@ -0,0 +1,72 @@
+package main
+
+import "testing"
+
+// testStoreImplementation exercises the Store contract against any
+// implementation; the Redis and Postgres backends run it too, in
+// store_integration_test.go.
+func testStoreImplementation(t *testing.T, s Store) {
+	t.Helper()
+
+	receipt := Receipt{
+		Retailer:     "Target",
+		PurchaseDate: "2022-01-01",
+		PurchaseTime: "13:01",
+		Items: []Item{
+			{ShortDescription: "Pepsi - 12-oz", Price: "1.25"},
+		},
+		Total: "35.35",
+	}
+
+	const id = "4f8d9b2a-6f3e-4c9a-9e2b-1a2b3c4d5e6f"
+	breakdown := []RuleResult{
+		{Rule: "retailer_name_alnum", Points: 6, Explanation: "6 points - retailer name has 6 characters"},
+	}
+
+	if _, exists, err := s.GetPoints("missing"); err != nil {
+		t.Fatalf("GetPoints(missing): unexpected error: %v", err)
+	} else if exists {
+		t.Fatalf("GetPoints(missing): expected no receipt, got one")
+	}
+
+	if err := s.SaveReceipt(id, receipt, 42, breakdown); err != nil {
+		t.Fatalf("SaveReceipt: %v", err)
+	}
+
+	points, exists, err := s.GetPoints(id)
+	if err != nil {
+		t.Fatalf("GetPoints: unexpected error: %v", err)
+	}
+	if !exists {
+		t.Fatalf("GetPoints: expected a receipt to exist")
+	}
+	if points != 42 {
+		t.Errorf("GetPoints: got %d points, want 42", points)
+	}
+
+	got, exists, err := s.GetReceipt(id)
+	if err != nil {
+		t.Fatalf("GetReceipt: unexpected error: %v", err)
+	}
+	if !exists {
+		t.Fatalf("GetReceipt: expected a receipt to exist")
+	}
+	if got.Retailer != receipt.Retailer || got.Total != receipt.Total {
+		t.Errorf("GetReceipt: got %+v, want %+v", got, receipt)
+	}
+
+	gotBreakdown, exists, err := s.GetBreakdown(id)
+	if err != nil {
+		t.Fatalf("GetBreakdown: unexpected error: %v", err)
+	}
+	if !exists {
+		t.Fatalf("GetBreakdown: expected a receipt to exist")
+	}
+	if len(gotBreakdown) != len(breakdown) || gotBreakdown[0] != breakdown[0] {
+		t.Errorf("GetBreakdown: got %+v, want %+v", gotBreakdown, breakdown)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStoreImplementation(t, NewMemoryStore())
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// CheckOrigin is left nil, so gorilla's default same-origin check
+	// applies: receipts are processed same-origin today; loosen only if a
+	// real cross-origin client shows up.
+}
+
+// rpcRequest is the JSON-RPC-style envelope clients send over the
+// /receipts/subscribe socket to manage subscriptions.
+type rpcRequest struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type rpcResponse struct {
+	Method string      `json:"method"`
+	Result interface{} `json:"result,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// handleSubscribe upgrades the connection to a WebSocket and lets a
+// client multiplex many EventBus subscriptions over it via
+// {"method":"subscribe","params":{...Filter}} and
+// {"method":"unsubscribe","params":["<id>"]} messages.
+func handleSubscribe(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("subscribe: upgrade failed: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	var writeMu sync.Mutex
+	write := func(v interface{}) {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		if err := conn.WriteJSON(v); err != nil {
+			log.Printf("subscribe: write failed: %v", err)
+		}
+	}
+
+	subs := make(map[string]func())
+	defer func() {
+		for _, unsub := range subs {
+			unsub()
+		}
+	}()
+
+	for {
+		var req rpcRequest
+		if err := conn.ReadJSON(&req); err != nil {
+			return
+		}
+
+		switch req.Method {
+		case "subscribe":
+			var filter Filter
+			if err := json.Unmarshal(req.Params, &filter); err != nil {
+				write(rpcResponse{Method: "subscribe", Error: "invalid filter: " + err.Error()})
+				continue
+			}
+
+			id, ch, err := eventBus.Subscribe(filter)
+			if err != nil {
+				write(rpcResponse{Method: "subscribe", Error: err.Error()})
+				continue
+			}
+			subs[id] = func() { eventBus.Unsubscribe(id) }
+
+			go func(id string, ch <-chan Event) {
+				for event := range ch {
+					write(event)
+				}
+			}(id, ch)
+
+			write(rpcResponse{Method: "subscribe", Result: map[string]string{"id": id}})
+
+		case "unsubscribe":
+			var ids []string
+			if err := json.Unmarshal(req.Params, &ids); err != nil {
+				write(rpcResponse{Method: "unsubscribe", Error: "invalid params: " + err.Error()})
+				continue
+			}
+			for _, id := range ids {
+				if unsub, ok := subs[id]; ok {
+					unsub()
+					delete(subs, id)
+				}
+			}
+			write(rpcResponse{Method: "unsubscribe", Result: ids})
+
+		default:
+			write(rpcResponse{Method: req.Method, Error: "unknown method"})
+		}
+	}
+}